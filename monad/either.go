@@ -0,0 +1,135 @@
+package monad
+
+import "context"
+
+// Either holds exactly one of a "left" (conventionally a failure) or
+// "right" (conventionally a success) value, letting a pipeline carry *why*
+// it failed instead of bolting a plain error onto every return.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left wraps a left value in an Either.
+func Left[L, R any](l L) Either[L, R] {
+	return Either[L, R]{left: l}
+}
+
+// Right wraps a right value in an Either.
+func Right[L, R any](r R) Either[L, R] {
+	return Either[L, R]{right: r, isRight: true}
+}
+
+// Result is an Either specialized to the common "typed error or value" case;
+// it embeds Either so it shares IsLeft/IsRight/LeftValue/RightValue directly.
+type Result[T any] struct {
+	Either[error, T]
+}
+
+// IsLeft reports whether e holds a left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight reports whether e holds a right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// LeftValue returns e's left value and true, or the zero value and false if
+// e holds a right value.
+func (e Either[L, R]) LeftValue() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// RightValue returns e's right value and true, or the zero value and false
+// if e holds a left value.
+func (e Either[L, R]) RightValue() (R, bool) {
+	return e.right, e.isRight
+}
+
+// MapEither transforms a right value, passing a left value through unchanged.
+func MapEither[L, A, B any](e Either[L, A], fn func(A) B) Either[L, B] {
+	if e.IsLeft() {
+		l, _ := e.LeftValue()
+		return Left[L, B](l)
+	}
+	a, _ := e.RightValue()
+	return Right[L, B](fn(a))
+}
+
+// FlatMapEither chains a right value into another Either-producing step,
+// short-circuiting on a left value.
+func FlatMapEither[L, A, B any](e Either[L, A], fn func(A) Either[L, B]) Either[L, B] {
+	if e.IsLeft() {
+		l, _ := e.LeftValue()
+		return Left[L, B](l)
+	}
+	a, _ := e.RightValue()
+	return fn(a)
+}
+
+// MapLeft transforms a left value, passing a right value through unchanged.
+func MapLeft[L, R, M any](e Either[L, R], fn func(L) M) Either[M, R] {
+	if e.IsLeft() {
+		l, _ := e.LeftValue()
+		return Left[M, R](fn(l))
+	}
+	r, _ := e.RightValue()
+	return Right[M, R](r)
+}
+
+// Fold collapses an Either into a single value by applying onLeft or onRight.
+func Fold[L, R, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.IsLeft() {
+		l, _ := e.LeftValue()
+		return onLeft(l)
+	}
+	r, _ := e.RightValue()
+	return onRight(r)
+}
+
+// FromError folds a (value, error) pair into a Result, the way Go's native
+// calling convention is usually consumed.
+func FromError[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{Left[error, T](err)}
+	}
+	return Result[T]{Right[error, T](v)}
+}
+
+// ToError unpacks a Result back into Go's native (value, error) pair.
+func ToError[T any](r Result[T]) (T, error) {
+	if l, ok := r.LeftValue(); ok {
+		return *new(T), l
+	}
+	v, _ := r.RightValue()
+	return v, nil
+}
+
+// AsResult folds a Future's (value, error) outcome into a Future of a
+// Result, so downstream combinators can pattern-match on it instead of
+// handling a dual return.
+func AsResult[A any](f *Future[A]) *Future[Result[A]] {
+	derived := NewFuture(func(ctx context.Context) (Result[A], error) {
+		value, err := f.GetContext(ctx)
+		return FromError(value, err), nil
+	})
+	chainCancel(derived, f)
+	return derived
+}
+
+// FromResult unfolds a Future of a Result back into a plain Future, where a
+// left value surfaces as the Future's error.
+func FromResult[A any](f *Future[Result[A]]) *Future[A] {
+	derived := NewFuture(func(ctx context.Context) (A, error) {
+		res, err := f.GetContext(ctx)
+		if err != nil {
+			return *new(A), err
+		}
+		return ToError(res)
+	})
+	chainCancel(derived, f)
+	return derived
+}
@@ -0,0 +1,159 @@
+package monad
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestEitherAccessors(t *testing.T) {
+	left := Left[string, int]("oops")
+	if !left.IsLeft() || left.IsRight() {
+		t.Fatal("expected a left Either")
+	}
+	if l, ok := left.LeftValue(); !ok || l != "oops" {
+		t.Fatalf("expected LeftValue to return (\"oops\", true), got (%v, %v)", l, ok)
+	}
+	if _, ok := left.RightValue(); ok {
+		t.Fatal("expected RightValue to report false on a left Either")
+	}
+
+	right := Right[string, int](42)
+	if !right.IsRight() || right.IsLeft() {
+		t.Fatal("expected a right Either")
+	}
+	if r, ok := right.RightValue(); !ok || r != 42 {
+		t.Fatalf("expected RightValue to return (42, true), got (%v, %v)", r, ok)
+	}
+}
+
+func TestMapEither(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Either[string, int]
+		want Either[string, int]
+	}{
+		{"maps a right value", Right[string, int](21), Right[string, int](42)},
+		{"passes a left value through", Left[string, int]("fail"), Left[string, int]("fail")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MapEither(tc.in, func(n int) int { return n * 2 })
+			assertEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestFlatMapEitherShortCircuits(t *testing.T) {
+	double := func(n int) Either[string, int] { return Right[string, int](n * 2) }
+
+	tests := []struct {
+		name string
+		in   Either[string, int]
+		want Either[string, int]
+	}{
+		{"chains through a right value", Right[string, int](21), Right[string, int](42)},
+		{"short-circuits on a left value", Left[string, int]("boom"), Left[string, int]("boom")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := FlatMapEither(tc.in, double)
+			assertEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestFold(t *testing.T) {
+	onLeft := func(s string) string { return "error: " + s }
+	onRight := func(n int) string { return "ok: " + strconv.Itoa(n) }
+
+	assertEqual(t, "ok: 42", Fold(Right[string, int](42), onLeft, onRight))
+	assertEqual(t, "error: boom", Fold(Left[string, int]("boom"), onLeft, onRight))
+}
+
+func TestFromErrorToErrorRoundTrip(t *testing.T) {
+	t.Run("success round-trips", func(t *testing.T) {
+		r := FromError(42, nil)
+		value, err := ToError(r)
+		assertEqual(t, nil, err)
+		assertEqual(t, 42, value)
+	})
+
+	t.Run("failure round-trips", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		r := FromError(0, expectedErr)
+		value, err := ToError(r)
+		assertEqual(t, expectedErr, err)
+		assertEqual(t, 0, value)
+	})
+}
+
+func TestAsResultFromResult(t *testing.T) {
+	t.Run("AsResult folds a successful Future", func(t *testing.T) {
+		f := Successful(42)
+		result, err := AsResult(f).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, true, result.IsRight())
+		v, _ := result.RightValue()
+		assertEqual(t, 42, v)
+	})
+
+	t.Run("AsResult folds a failed Future", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		f := Failed[int](expectedErr)
+		result, err := AsResult(f).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, true, result.IsLeft())
+		l, _ := result.LeftValue()
+		assertEqual(t, expectedErr, l)
+	})
+
+	t.Run("FromResult unfolds back to a plain Future", func(t *testing.T) {
+		f := Successful(42)
+		value, err := FromResult(AsResult(f)).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, 42, value)
+	})
+}
+
+func TestParseNumberResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{"valid number", "42", 42, false},
+		{"malformed number", "not-a-number", 0, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := parseNumberResult(tc.in)
+			value, err := ToError(result)
+			if tc.wantErr {
+				var numErr *strconv.NumError
+				if !errors.As(err, &numErr) {
+					t.Fatalf("expected a *strconv.NumError, got %v", err)
+				}
+				return
+			}
+			assertEqual(t, nil, err)
+			assertEqual(t, tc.want, value)
+		})
+	}
+}
+
+func TestGetFromMapResult(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	result := getFromMapResult(m, "a")
+	value, err := ToError(result)
+	assertEqual(t, nil, err)
+	assertEqual(t, 1, value)
+
+	_, err = ToError(getFromMapResult(m, "missing"))
+	var notFound KeyNotFoundError[string]
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected a KeyNotFoundError, got %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+package monad
+
+import (
+	"context"
+	"errors"
+)
+
+// Pair holds the results of two heterogeneous Futures combined by Zip2.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds the results of three heterogeneous Futures combined by Zip3.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Race returns a Future that resolves with whichever of futures completes
+// first, success or failure, and cancels the rest.
+func Race[A any](futures ...*Future[A]) *Future[A] {
+	derived := NewFuture(func(ctx context.Context) (A, error) {
+		type outcome struct {
+			value A
+			err   error
+		}
+		results := make(chan outcome, len(futures))
+		for _, future := range futures {
+			future := future
+			go func() {
+				value, err := future.GetContext(ctx)
+				results <- outcome{value, err}
+			}()
+		}
+
+		select {
+		case res := <-results:
+			for _, future := range futures {
+				future.Cancel()
+			}
+			return res.value, res.err
+		case <-ctx.Done():
+			return *new(A), ctx.Err()
+		}
+	})
+
+	upstreams := make([]canceler, len(futures))
+	for i, future := range futures {
+		upstreams[i] = future
+	}
+	chainCancel(derived, upstreams...)
+	return derived
+}
+
+// Example usage of Race:
+// fastest := Race(future1, future2, future3)
+
+// FirstSuccess returns a Future that resolves with the first Future in
+// futures to succeed, cancelling the rest. It only fails once every input
+// has failed, joining their errors together.
+func FirstSuccess[A any](futures ...*Future[A]) *Future[A] {
+	derived := NewFuture(func(ctx context.Context) (A, error) {
+		type outcome struct {
+			value A
+			err   error
+		}
+		results := make(chan outcome, len(futures))
+		for _, future := range futures {
+			future := future
+			go func() {
+				value, err := future.GetContext(ctx)
+				results <- outcome{value, err}
+			}()
+		}
+
+		var errs []error
+		for range futures {
+			select {
+			case res := <-results:
+				if res.err == nil {
+					for _, future := range futures {
+						future.Cancel()
+					}
+					return res.value, nil
+				}
+				errs = append(errs, res.err)
+			case <-ctx.Done():
+				return *new(A), ctx.Err()
+			}
+		}
+		return *new(A), errors.Join(errs...)
+	})
+
+	upstreams := make([]canceler, len(futures))
+	for i, future := range futures {
+		upstreams[i] = future
+	}
+	chainCancel(derived, upstreams...)
+	return derived
+}
+
+// Example usage of FirstSuccess:
+// primary := FirstSuccess(mirror1, mirror2, mirror3)
+
+// Zip2 runs fa and fb in parallel and combines their results into a Pair.
+// If either fails, the Zip short-circuits with that error and cancels the
+// other.
+func Zip2[A, B any](fa *Future[A], fb *Future[B]) *Future[Pair[A, B]] {
+	derived := NewFuture(func(ctx context.Context) (Pair[A, B], error) {
+		type aResult struct {
+			value A
+			err   error
+		}
+		type bResult struct {
+			value B
+			err   error
+		}
+		aCh := make(chan aResult, 1)
+		bCh := make(chan bResult, 1)
+
+		go func() {
+			value, err := fa.GetContext(ctx)
+			aCh <- aResult{value, err}
+		}()
+		go func() {
+			value, err := fb.GetContext(ctx)
+			bCh <- bResult{value, err}
+		}()
+
+		var a aResult
+		var b bResult
+		for i := 0; i < 2; i++ {
+			select {
+			case a = <-aCh:
+				if a.err != nil {
+					fb.Cancel()
+					return Pair[A, B]{}, a.err
+				}
+			case b = <-bCh:
+				if b.err != nil {
+					fa.Cancel()
+					return Pair[A, B]{}, b.err
+				}
+			case <-ctx.Done():
+				return Pair[A, B]{}, ctx.Err()
+			}
+		}
+		return Pair[A, B]{First: a.value, Second: b.value}, nil
+	})
+	chainCancel(derived, fa, fb)
+	return derived
+}
+
+// Example usage of Zip2:
+// combined := Zip2(fetchUser(1), fetchOrders(1))
+
+// Zip3 runs fa, fb, and fc in parallel and combines their results into a
+// Triple, short-circuiting and cancelling the others on the first error.
+func Zip3[A, B, C any](fa *Future[A], fb *Future[B], fc *Future[C]) *Future[Triple[A, B, C]] {
+	derived := NewFuture(func(ctx context.Context) (Triple[A, B, C], error) {
+		type aResult struct {
+			value A
+			err   error
+		}
+		type bResult struct {
+			value B
+			err   error
+		}
+		type cResult struct {
+			value C
+			err   error
+		}
+		aCh := make(chan aResult, 1)
+		bCh := make(chan bResult, 1)
+		cCh := make(chan cResult, 1)
+
+		go func() {
+			value, err := fa.GetContext(ctx)
+			aCh <- aResult{value, err}
+		}()
+		go func() {
+			value, err := fb.GetContext(ctx)
+			bCh <- bResult{value, err}
+		}()
+		go func() {
+			value, err := fc.GetContext(ctx)
+			cCh <- cResult{value, err}
+		}()
+
+		var a aResult
+		var b bResult
+		var c cResult
+		for i := 0; i < 3; i++ {
+			select {
+			case a = <-aCh:
+				if a.err != nil {
+					fb.Cancel()
+					fc.Cancel()
+					return Triple[A, B, C]{}, a.err
+				}
+			case b = <-bCh:
+				if b.err != nil {
+					fa.Cancel()
+					fc.Cancel()
+					return Triple[A, B, C]{}, b.err
+				}
+			case c = <-cCh:
+				if c.err != nil {
+					fa.Cancel()
+					fb.Cancel()
+					return Triple[A, B, C]{}, c.err
+				}
+			case <-ctx.Done():
+				return Triple[A, B, C]{}, ctx.Err()
+			}
+		}
+		return Triple[A, B, C]{First: a.value, Second: b.value, Third: c.value}, nil
+	})
+	chainCancel(derived, fa, fb, fc)
+	return derived
+}
+
+// Example usage of Zip3:
+// combined := Zip3(fetchUser(1), fetchOrders(1), fetchInvoice(1))
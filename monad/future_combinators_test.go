@@ -0,0 +1,150 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRace(t *testing.T) {
+	t.Run("returns the fastest result", func(t *testing.T) {
+		slow := NewFuture(func(ctx context.Context) (int, error) {
+			time.Sleep(100 * time.Millisecond)
+			return 1, nil
+		})
+		fast := NewFuture(func(ctx context.Context) (int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return 2, nil
+		})
+
+		result, err := Race(slow, fast).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, 2, result)
+	})
+
+	t.Run("cancels the losers", func(t *testing.T) {
+		loserCancelled := make(chan struct{})
+		loser := NewFuture(func(ctx context.Context) (int, error) {
+			select {
+			case <-ctx.Done():
+				close(loserCancelled)
+			case <-time.After(time.Second):
+			}
+			return 0, ctx.Err()
+		})
+		winner := NewFuture(func(ctx context.Context) (int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return 42, nil
+		})
+
+		result, err := Race(loser, winner).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, 42, result)
+
+		select {
+		case <-loserCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("expected losing Future to be cancelled")
+		}
+	})
+}
+
+func TestFirstSuccess(t *testing.T) {
+	t.Run("returns the first success", func(t *testing.T) {
+		f1 := Failed[int](errors.New("f1 failed"))
+		f2 := NewFuture(func(ctx context.Context) (int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return 7, nil
+		})
+
+		result, err := FirstSuccess(f1, f2).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, 7, result)
+	})
+
+	t.Run("fails only once every Future has failed", func(t *testing.T) {
+		f1 := Failed[int](errors.New("f1 failed"))
+		f2 := Failed[int](errors.New("f2 failed"))
+
+		_, err := FirstSuccess(f1, f2).Get()
+		if err == nil {
+			t.Fatal("expected an aggregated error but got nil")
+		}
+		msg := err.Error()
+		if !strings.Contains(msg, "f1 failed") || !strings.Contains(msg, "f2 failed") {
+			t.Fatalf("expected joined error to mention both failures, got %q", msg)
+		}
+	})
+}
+
+func TestZip2(t *testing.T) {
+	t.Run("combines two successful futures", func(t *testing.T) {
+		fa := Successful(1)
+		fb := Successful("two")
+
+		result, err := Zip2(fa, fb).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, 1, result.First)
+		assertEqual(t, "two", result.Second)
+	})
+
+	t.Run("short-circuits and cancels the other on error", func(t *testing.T) {
+		expectedErr := errors.New("fa failed")
+		fa := NewFuture(func(ctx context.Context) (int, error) {
+			return 0, expectedErr
+		})
+		bCancelled := make(chan struct{})
+		fb := NewFuture(func(ctx context.Context) (string, error) {
+			select {
+			case <-ctx.Done():
+				close(bCancelled)
+			case <-time.After(time.Second):
+			}
+			return "", ctx.Err()
+		})
+
+		_, err := Zip2(fa, fb).Get()
+		assertEqual(t, expectedErr, err)
+
+		select {
+		case <-bCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("expected fb to be cancelled")
+		}
+	})
+}
+
+func TestZip3(t *testing.T) {
+	t.Run("combines three successful futures", func(t *testing.T) {
+		fa := Successful(1)
+		fb := Successful("two")
+		fc := Successful(3.0)
+
+		result, err := Zip3(fa, fb, fc).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, 1, result.First)
+		assertEqual(t, "two", result.Second)
+		assertEqual(t, 3.0, result.Third)
+	})
+}
+
+func BenchmarkZip2Parallel(b *testing.B) {
+	const delay = 50 * time.Millisecond
+	for i := 0; i < b.N; i++ {
+		fa := NewFuture(func(ctx context.Context) (int, error) {
+			time.Sleep(delay)
+			return 1, nil
+		})
+		fb := NewFuture(func(ctx context.Context) (int, error) {
+			time.Sleep(delay)
+			return 2, nil
+		})
+		if _, err := Zip2(fa, fb).Get(); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// With b.N == 1, wall time should sit near `delay`, not 2*delay,
+	// confirming fa and fb run in parallel rather than sequentially.
+}
@@ -0,0 +1,228 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RetryPolicy configures Retry's jittered exponential backoff.
+type RetryPolicy struct {
+	MaxAttempts    int           // Total attempts, including the first. Must be >= 1.
+	InitialBackoff time.Duration // Backoff before the second attempt.
+	MaxBackoff     time.Duration // Upper bound on any single backoff.
+	Multiplier     float64       // Growth factor applied per attempt.
+	Jitter         float64       // Fraction of the computed backoff to randomize, in [0, 1].
+	ShouldRetry    func(error) bool
+}
+
+// backoffFor returns the backoff duration before the given (zero-indexed)
+// retry attempt: InitialBackoff * Multiplier^attempt, capped at MaxBackoff,
+// with a Jitter fraction of it randomized. Jitter 1 gives full jitter
+// (uniform in [0, capped backoff)); Jitter 0 gives no randomization.
+func (p RetryPolicy) backoffFor(attempt int) time.Duration {
+	maxBackoff := float64(p.MaxBackoff)
+	raw := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt))
+	if raw > maxBackoff {
+		raw = maxBackoff
+	}
+	jittered := raw * (1 - p.Jitter + p.Jitter*rand.Float64())
+	return time.Duration(jittered)
+}
+
+// Retry runs attempt repeatedly until it succeeds, policy.ShouldRetry(err)
+// returns false, or policy.MaxAttempts is exhausted, using jittered
+// exponential backoff between attempts (see RetryPolicy.Jitter). Cancellation is governed by the
+// Future Retry returns: cancelling it aborts the backoff wait and cancels
+// whichever attempt Future is currently in flight.
+func Retry[A any](attempt func() *Future[A], policy RetryPolicy) *Future[A] {
+	return NewFuture(func(ctx context.Context) (A, error) {
+		var lastErr error
+		attempts := 0
+		for i := 0; i < policy.MaxAttempts; i++ {
+			attempts++
+			f := attempt()
+			value, err := f.GetContext(ctx)
+			if err == nil {
+				return value, nil
+			}
+			if ctx.Err() != nil {
+				// ctx fired while this attempt was still in flight; cancel
+				// it so it doesn't keep running after Retry gives up.
+				f.Cancel()
+				return *new(A), ctx.Err()
+			}
+			lastErr = err
+
+			if policy.ShouldRetry != nil && !policy.ShouldRetry(err) {
+				break
+			}
+			if i == policy.MaxAttempts-1 {
+				break
+			}
+
+			select {
+			case <-time.After(policy.backoffFor(i)):
+			case <-ctx.Done():
+				return *new(A), ctx.Err()
+			}
+		}
+		return *new(A), fmt.Errorf("retry: giving up after %d attempts: %w", attempts, lastErr)
+	})
+}
+
+// CircuitState is a CircuitBreaker's state in the Closed/Open/HalfOpen machine.
+type CircuitState int
+
+const (
+	Closed CircuitState = iota
+	Open
+	HalfOpen
+)
+
+// ErrCircuitOpen is returned by Guard when the breaker is Open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerConfig configures a CircuitBreaker.
+type CircuitBreakerConfig struct {
+	FailureThreshold int           // Consecutive failures before tripping to Open.
+	SuccessThreshold int           // Consecutive HalfOpen successes before closing again.
+	OpenTimeout      time.Duration // How long to stay Open before allowing a HalfOpen probe.
+	IsFailure        func(error) bool
+}
+
+// CircuitBreaker guards a factory of Futures, short-circuiting calls while
+// the downstream dependency looks unhealthy.
+type CircuitBreaker[A any] struct {
+	cfg CircuitBreakerConfig
+
+	mutex     sync.Mutex
+	state     CircuitState
+	failures  int
+	successes int
+	openedAt  time.Time
+	probing   bool
+}
+
+// NewCircuitBreaker creates a CircuitBreaker starting in the Closed state.
+func NewCircuitBreaker[A any](cfg CircuitBreakerConfig) *CircuitBreaker[A] {
+	return &CircuitBreaker[A]{cfg: cfg, state: Closed}
+}
+
+// State returns the breaker's current state.
+func (cb *CircuitBreaker[A]) State() CircuitState {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	return cb.state
+}
+
+// allow reports whether a call may proceed, and if so whether it is the
+// single HalfOpen probe.
+func (cb *CircuitBreaker[A]) allow() (proceed bool, isProbe bool) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	switch cb.state {
+	case Closed:
+		return true, false
+	case Open:
+		if time.Since(cb.openedAt) < cb.cfg.OpenTimeout {
+			return false, false
+		}
+		cb.state = HalfOpen
+		cb.successes = 0
+		cb.probing = true
+		return true, true
+	case HalfOpen:
+		if cb.probing {
+			return false, false
+		}
+		cb.probing = true
+		return true, true
+	default:
+		return true, false
+	}
+}
+
+// recordResult updates the state machine based on the outcome of a call.
+func (cb *CircuitBreaker[A]) recordResult(err error) {
+	isFailure := err != nil
+	if cb.cfg.IsFailure != nil {
+		isFailure = cb.cfg.IsFailure(err)
+	}
+
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	switch cb.state {
+	case HalfOpen:
+		cb.probing = false
+		if isFailure {
+			cb.state = Open
+			cb.openedAt = time.Now()
+			cb.failures = 0
+			return
+		}
+		cb.successes++
+		if cb.successes >= cb.cfg.SuccessThreshold {
+			cb.state = Closed
+			cb.failures = 0
+			cb.successes = 0
+		}
+	default: // Closed, or Open for a call that started before the breaker tripped
+		if isFailure {
+			cb.failures++
+			if cb.failures >= cb.cfg.FailureThreshold {
+				cb.state = Open
+				cb.openedAt = time.Now()
+			}
+		} else {
+			cb.failures = 0
+		}
+	}
+}
+
+// Guard runs factory through the breaker, short-circuiting with
+// ErrCircuitOpen when Open and allowing a single probe in HalfOpen.
+func (cb *CircuitBreaker[A]) Guard(factory func() *Future[A]) *Future[A] {
+	proceed, _ := cb.allow()
+	if !proceed {
+		return Failed[A](ErrCircuitOpen)
+	}
+
+	return NewFuture(func(ctx context.Context) (A, error) {
+		f := factory()
+		value, err := f.GetContext(ctx)
+		if ctx.Err() != nil {
+			// ctx fired while factory's Future was still in flight; cancel
+			// it so it doesn't keep running after Guard gives up.
+			f.Cancel()
+		}
+		cb.recordResult(err)
+		return value, err
+	})
+}
+
+// RateLimit waits on limiter before invoking factory, using the resulting
+// Future's context so waiting can be cancelled.
+func RateLimit[A any](factory func() *Future[A], limiter *rate.Limiter) *Future[A] {
+	return NewFuture(func(ctx context.Context) (A, error) {
+		if err := limiter.Wait(ctx); err != nil {
+			return *new(A), err
+		}
+		f := factory()
+		value, err := f.GetContext(ctx)
+		if ctx.Err() != nil {
+			// ctx fired while factory's Future was still in flight; cancel
+			// it so it doesn't keep running after RateLimit gives up.
+			f.Cancel()
+		}
+		return value, err
+	})
+}
@@ -0,0 +1,200 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	t.Run("succeeds after a few failures", func(t *testing.T) {
+		attempts := 0
+		factory := func() *Future[int] {
+			attempts++
+			if attempts < 3 {
+				return Failed[int](errors.New("not yet"))
+			}
+			return Successful(42)
+		}
+
+		f := Retry(factory, RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			Multiplier:     2,
+			Jitter:         0,
+		})
+
+		result, err := f.Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, 42, result)
+		assertEqual(t, 3, attempts)
+	})
+
+	t.Run("gives up after MaxAttempts", func(t *testing.T) {
+		attempts := 0
+		factory := func() *Future[int] {
+			attempts++
+			return Failed[int](errors.New("always fails"))
+		}
+
+		f := Retry(factory, RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			Multiplier:     2,
+			Jitter:         0,
+		})
+
+		_, err := f.Get()
+		if err == nil {
+			t.Fatal("expected an error but got nil")
+		}
+		assertEqual(t, 3, attempts)
+	})
+
+	t.Run("stops early when ShouldRetry rejects the error", func(t *testing.T) {
+		attempts := 0
+		sentinel := errors.New("not retryable")
+		factory := func() *Future[int] {
+			attempts++
+			return Failed[int](sentinel)
+		}
+
+		f := Retry(factory, RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			Multiplier:     2,
+			ShouldRetry:    func(err error) bool { return !errors.Is(err, sentinel) },
+		})
+
+		_, err := f.Get()
+		if err == nil {
+			t.Fatal("expected an error but got nil")
+		}
+		assertEqual(t, 1, attempts)
+	})
+
+	t.Run("cancelling mid-attempt cancels the in-flight attempt", func(t *testing.T) {
+		attemptCancelled := make(chan struct{})
+		attemptStarted := make(chan struct{})
+
+		factory := func() *Future[int] {
+			return NewFuture(func(ctx context.Context) (int, error) {
+				close(attemptStarted)
+				<-ctx.Done()
+				close(attemptCancelled)
+				return 0, ctx.Err()
+			})
+		}
+
+		f := Retry(factory, RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			Multiplier:     2,
+		})
+
+		<-attemptStarted
+		f.Cancel()
+
+		select {
+		case <-attemptCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("expected cancelling Retry's Future to cancel the in-flight attempt")
+		}
+	})
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		cb := NewCircuitBreaker[int](CircuitBreakerConfig{
+			FailureThreshold: 2,
+			SuccessThreshold: 1,
+			OpenTimeout:      time.Hour,
+		})
+
+		failing := func() *Future[int] { return Failed[int](errors.New("boom")) }
+
+		if _, err := cb.Guard(failing).Get(); err == nil {
+			t.Fatal("expected first failure to propagate")
+		}
+		assertEqual(t, Closed, cb.State())
+
+		if _, err := cb.Guard(failing).Get(); err == nil {
+			t.Fatal("expected second failure to propagate")
+		}
+		assertEqual(t, Open, cb.State())
+
+		_, err := cb.Guard(failing).Get()
+		if !errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected ErrCircuitOpen, got %v", err)
+		}
+	})
+
+	t.Run("half-open probe recovers to closed", func(t *testing.T) {
+		cb := NewCircuitBreaker[int](CircuitBreakerConfig{
+			FailureThreshold: 1,
+			SuccessThreshold: 1,
+			OpenTimeout:      10 * time.Millisecond,
+		})
+
+		_, _ = cb.Guard(func() *Future[int] { return Failed[int](errors.New("boom")) }).Get()
+		assertEqual(t, Open, cb.State())
+
+		time.Sleep(20 * time.Millisecond)
+
+		result, err := cb.Guard(func() *Future[int] { return Successful(7) }).Get()
+		assertEqual(t, nil, err)
+		assertEqual(t, 7, result)
+		assertEqual(t, Closed, cb.State())
+	})
+
+	t.Run("half-open probe failure reopens", func(t *testing.T) {
+		cb := NewCircuitBreaker[int](CircuitBreakerConfig{
+			FailureThreshold: 1,
+			SuccessThreshold: 1,
+			OpenTimeout:      10 * time.Millisecond,
+		})
+
+		_, _ = cb.Guard(func() *Future[int] { return Failed[int](errors.New("boom")) }).Get()
+		time.Sleep(20 * time.Millisecond)
+
+		_, err := cb.Guard(func() *Future[int] { return Failed[int](errors.New("still broken")) }).Get()
+		if err == nil {
+			t.Fatal("expected probe failure to propagate")
+		}
+		assertEqual(t, Open, cb.State())
+	})
+
+	t.Run("cancelling mid-call cancels the in-flight factory Future", func(t *testing.T) {
+		factoryCancelled := make(chan struct{})
+		factoryStarted := make(chan struct{})
+
+		cb := NewCircuitBreaker[int](CircuitBreakerConfig{
+			FailureThreshold: 1,
+			SuccessThreshold: 1,
+			OpenTimeout:      time.Hour,
+		})
+
+		f := cb.Guard(func() *Future[int] {
+			return NewFuture(func(ctx context.Context) (int, error) {
+				close(factoryStarted)
+				<-ctx.Done()
+				close(factoryCancelled)
+				return 0, ctx.Err()
+			})
+		})
+
+		<-factoryStarted
+		f.Cancel()
+
+		select {
+		case <-factoryCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("expected cancelling Guard's Future to cancel the in-flight factory Future")
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package monad
+
+// Maybe holds an optional value: either Just a V, or Nothing.
+type Maybe[V any] struct {
+	value V
+	ok    bool
+}
+
+// Just wraps a present value.
+func Just[V any](v V) Maybe[V] {
+	return Maybe[V]{value: v, ok: true}
+}
+
+// Nothing represents an absent value.
+func Nothing[V any]() Maybe[V] {
+	return Maybe[V]{}
+}
+
+// IsJust reports whether m holds a value.
+func (m Maybe[V]) IsJust() bool {
+	return m.ok
+}
+
+// IsNothing reports whether m holds no value.
+func (m Maybe[V]) IsNothing() bool {
+	return !m.ok
+}
+
+// Value returns m's value and true, or the zero value and false if m is Nothing.
+func (m Maybe[V]) Value() (V, bool) {
+	return m.value, m.ok
+}
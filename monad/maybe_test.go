@@ -0,0 +1,54 @@
+package monad
+
+import "testing"
+
+func TestMaybe(t *testing.T) {
+	t.Run("Just holds a value", func(t *testing.T) {
+		m := Just(42)
+		if !m.IsJust() || m.IsNothing() {
+			t.Fatal("expected a Just")
+		}
+		v, ok := m.Value()
+		assertEqual(t, true, ok)
+		assertEqual(t, 42, v)
+	})
+
+	t.Run("Nothing holds no value", func(t *testing.T) {
+		m := Nothing[int]()
+		if m.IsJust() || !m.IsNothing() {
+			t.Fatal("expected a Nothing")
+		}
+		_, ok := m.Value()
+		assertEqual(t, false, ok)
+	})
+}
+
+func TestGetFromMap(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	v, ok := getFromMap(m, "a").Value()
+	assertEqual(t, true, ok)
+	assertEqual(t, 1, v)
+
+	_, ok = getFromMap(m, "missing").Value()
+	assertEqual(t, false, ok)
+}
+
+func TestParseNumber(t *testing.T) {
+	v, ok := parseNumber("42").Value()
+	assertEqual(t, true, ok)
+	assertEqual(t, 42, v)
+
+	_, ok = parseNumber("not-a-number").Value()
+	assertEqual(t, false, ok)
+}
+
+func TestFromNullable(t *testing.T) {
+	n := 7
+	v, ok := fromNullable(&n).Value()
+	assertEqual(t, true, ok)
+	assertEqual(t, 7, v)
+
+	_, ok = fromNullable[int](nil).Value()
+	assertEqual(t, false, ok)
+}
@@ -1,26 +1,58 @@
 package monad
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 )
 
 type Future[A any] struct {
-	value    A             // computed value of the Future.
-	err      error         // The error from the computation, if any.
-	done     bool          // Indicates if the computation is completed.
-	mutex    sync.RWMutex  // Mutex to protect access to `value` and `err`.
-	waitChan chan struct{} // Channel to signal when the computation is complete.
+	value    A                  // computed value of the Future.
+	err      error              // The error from the computation, if any.
+	done     bool               // Indicates if the computation is completed.
+	mutex    sync.RWMutex       // Mutex to protect access to `value` and `err`.
+	waitChan chan struct{}      // Channel to signal when the computation is complete.
+	ctx      context.Context    // Context governing the in-flight computation.
+	cancel   context.CancelFunc // Cancels `ctx`; also unwinds linked upstream Futures.
 }
 
-// Creates a new Future from a computation
-func NewFuture[A any](compute func() (A, error)) *Future[A] {
+// canceler is the subset of *Future[A] needed to propagate cancellation
+// across combinators without tying them to a single type parameter.
+type canceler interface {
+	Cancel()
+}
+
+// chainCancel makes cancelling f also cancel each of its upstream Futures.
+func chainCancel[A any](f *Future[A], upstreams ...canceler) {
+	orig := f.cancel
+	f.cancel = func() {
+		orig()
+		for _, up := range upstreams {
+			up.Cancel()
+		}
+	}
+}
+
+// NewFuture creates a new Future from a context-aware computation, rooted in
+// context.Background(). Use NewFutureWithContext to plug the Future into an
+// existing request-scoped context tree instead.
+func NewFuture[A any](compute func(context.Context) (A, error)) *Future[A] {
+	return NewFutureWithContext(context.Background(), compute)
+}
+
+// NewFutureWithContext creates a new Future whose computation is cancelled
+// whenever parent is done or Cancel is called.
+func NewFutureWithContext[A any](parent context.Context, compute func(context.Context) (A, error)) *Future[A] {
+	ctx, cancel := context.WithCancel(parent)
 	f := &Future[A]{
 		waitChan: make(chan struct{}), // close when done
+		ctx:      ctx,
+		cancel:   cancel,
 	}
 	go func() {
-		value, err := compute()
+		value, err := compute(ctx)
 		f.mutex.Lock()
 		f.value = value
 		f.err = err
@@ -33,20 +65,33 @@ func NewFuture[A any](compute func() (A, error)) *Future[A] {
 }
 
 // Example usage of NewFuture:
-// future := NewFuture(func() (int, error) {
+// future := NewFuture(func(ctx context.Context) (int, error) {
 //     time.Sleep(1 * time.Second)
 //     return 42, nil
 // })
 
+// Cancel cancels the Future's context, signalling its computation (and any
+// upstream Futures it was derived from) to stop early.
+func (f *Future[A]) Cancel() {
+	f.cancel()
+}
+
+// Context returns the context governing this Future's computation.
+func (f *Future[A]) Context() context.Context {
+	return f.ctx
+}
+
 // Map applies a transformation function to the Future's result, returning a new Future.
 func Map[A, B any](f *Future[A], fn func(A) B) *Future[B] {
-	return NewFuture(func() (B, error) {
-		value, err := f.Get() // Wait for `f` to complete
+	derived := NewFuture(func(ctx context.Context) (B, error) {
+		value, err := f.GetContext(ctx) // Wait for `f` to complete
 		if err != nil {
 			return *new(B), err
 		}
 		return fn(value), nil // Apply transformation and return result
 	})
+	chainCancel(derived, f)
+	return derived
 }
 
 // Example usage of Map:
@@ -54,18 +99,43 @@ func Map[A, B any](f *Future[A], fn func(A) B) *Future[B] {
 
 // FlatMap chains two Futures, allowing you to use the result of one to start another Future.
 func FlatMap[A, B any](f *Future[A], fn func(A) *Future[B]) *Future[B] {
-	return NewFuture(func() (B, error) {
-		value, err := f.Get() // Wait for `f` to complete
+	var innerMu sync.Mutex
+	var inner *Future[B]
+
+	derived := NewFuture(func(ctx context.Context) (B, error) {
+		value, err := f.GetContext(ctx) // Wait for `f` to complete
 		if err != nil {
 			return *new(B), err
 		}
-		return fn(value).Get() // Execute the next Future and return its result
+		next := fn(value)
+		innerMu.Lock()
+		inner = next
+		innerMu.Unlock()
+		result, err := next.GetContext(ctx) // Execute the next Future and return its result
+		if ctx.Err() != nil {
+			// derived was cancelled, possibly before `inner` was set above;
+			// cancel next directly so it doesn't keep running.
+			next.Cancel()
+		}
+		return result, err
 	})
+
+	orig := derived.cancel
+	derived.cancel = func() {
+		orig()
+		f.Cancel()
+		innerMu.Lock()
+		if inner != nil {
+			inner.Cancel()
+		}
+		innerMu.Unlock()
+	}
+	return derived
 }
 
 // Example usage of FlatMap:
 // result := FlatMap(future, func(x int) *Future[string] {
-//     return NewFuture(func() (string, error) {
+//     return NewFuture(func(ctx context.Context) (string, error) {
 //         return fmt.Sprintf("Result is %d", x), nil
 //     })
 // })
@@ -81,26 +151,71 @@ func (f *Future[A]) Get() (A, error) {
 // Example usage of Get:
 // result, err := future.Get()
 
-// GetWithTimeout waits for the Future to complete or times out after `timeout`.
-func (f *Future[A]) GetWithTimeout(timeout time.Duration) (A, error) {
+// GetContext waits for the Future to complete, returning early with ctx.Err()
+// if ctx fires before the Future does. An already-resolved Future always
+// wins the race, even if ctx happens to be done too (e.g. Successful/Failed
+// futures carry an immediately-cancelled context).
+func (f *Future[A]) GetContext(ctx context.Context) (A, error) {
+	select {
+	case <-f.waitChan:
+		f.mutex.RLock()
+		defer f.mutex.RUnlock()
+		return f.value, f.err
+	default:
+	}
+
 	select {
 	case <-f.waitChan: // Future completed
 		f.mutex.RLock()
 		defer f.mutex.RUnlock()
 		return f.value, f.err
-	case <-time.After(timeout): // Timeout reached
-		return *new(A), fmt.Errorf("timeout waiting for future")
+	case <-ctx.Done(): // Caller's context fired first
+		return *new(A), ctx.Err()
+	}
+}
+
+// Example usage of GetContext:
+// result, err := future.GetContext(ctx)
+
+// GetWithTimeout waits for the Future to complete or times out after `timeout`.
+// On timeout it cancels the Future's own context so its worker can exit early.
+func (f *Future[A]) GetWithTimeout(timeout time.Duration) (A, error) {
+	ctx, cancel := context.WithTimeout(f.ctx, timeout)
+	defer cancel()
+
+	value, err := f.GetContext(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		f.Cancel()
+		return value, fmt.Errorf("timeout waiting for future")
 	}
+	return value, err
 }
 
 // Example usage of GetWithTimeout:
 // result, err := future.GetWithTimeout(2 * time.Second)
 
+// newResolved builds a Future that is already complete with value/err,
+// wrapped in a cancellable context that is cancelled immediately: there is
+// no computation left to interrupt, so Cancel on a resolved Future is a
+// no-op, but Context() reports done like any other finished Future.
+func newResolved[A any](value A, err error) *Future[A] {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f := &Future[A]{
+		value:    value,
+		err:      err,
+		done:     true,
+		waitChan: make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	close(f.waitChan)
+	return f
+}
+
 // Successful returns a Future that completes immediately with a successful value.
 func Successful[A any](value A) *Future[A] {
-	return NewFuture(func() (A, error) {
-		return value, nil
-	})
+	return newResolved[A](value, nil)
 }
 
 // Example usage of Successful:
@@ -108,9 +223,7 @@ func Successful[A any](value A) *Future[A] {
 
 // Failed returns a Future that completes immediately with an error.
 func Failed[A any](err error) *Future[A] {
-	return NewFuture(func() (A, error) {
-		return *new(A), err
-	})
+	return newResolved(*new(A), err)
 }
 
 // Example usage of Failed:
@@ -118,17 +231,27 @@ func Failed[A any](err error) *Future[A] {
 
 // Sequence takes a slice of Futures and returns a Future of a slice of their results.
 func Sequence[A any](futures ...*Future[A]) *Future[[]A] {
-	return NewFuture(func() ([]A, error) {
+	derived := NewFuture(func(ctx context.Context) ([]A, error) {
 		results := make([]A, len(futures))
 		for i, future := range futures {
-			value, err := future.Get()
+			value, err := future.GetContext(ctx)
 			if err != nil {
+				for _, sibling := range futures {
+					sibling.Cancel() // A failing Future cancels its siblings.
+				}
 				return nil, err
 			}
 			results[i] = value
 		}
 		return results, nil
 	})
+
+	upstreams := make([]canceler, len(futures))
+	for i, future := range futures {
+		upstreams[i] = future
+	}
+	chainCancel(derived, upstreams...)
+	return derived
 }
 
 // Example usage of Sequence:
@@ -148,7 +271,7 @@ func main() {
 	fmt.Printf("Immediate Success: %v, Error: %v\n", value, err)
 
 	// Example 2: Future with delay and mapping to double the value
-	future := NewFuture(func() (int, error) {
+	future := NewFuture(func(ctx context.Context) (int, error) {
 		time.Sleep(1 * time.Second)
 		return 21, nil
 	})
@@ -160,13 +283,13 @@ func main() {
 
 	// Example 3: Chaining Futures with FlatMap (fetching and processing a user)
 	fetchUser := func(id int) *Future[string] {
-		return NewFuture(func() (string, error) {
+		return NewFuture(func(ctx context.Context) (string, error) {
 			time.Sleep(100 * time.Millisecond)
 			return fmt.Sprintf("User%d", id), nil
 		})
 	}
 	processUser := func(user string) *Future[string] {
-		return NewFuture(func() (string, error) {
+		return NewFuture(func(ctx context.Context) (string, error) {
 			time.Sleep(100 * time.Millisecond)
 			return fmt.Sprintf("Processed-%s", user), nil
 		})
@@ -177,11 +300,11 @@ func main() {
 	fmt.Printf("Processed User: %v, Error: %v\n", processedResult, err)
 
 	// Example 4: Using Sequence to combine multiple Futures
-	future1 := NewFuture(func() (int, error) {
+	future1 := NewFuture(func(ctx context.Context) (int, error) {
 		time.Sleep(300 * time.Millisecond)
 		return 5, nil
 	})
-	future2 := NewFuture(func() (int, error) {
+	future2 := NewFuture(func(ctx context.Context) (int, error) {
 		time.Sleep(100 * time.Millisecond)
 		return 10, nil
 	})
@@ -190,7 +313,7 @@ func main() {
 	fmt.Printf("Combined Results: %v, Error: %v\n", combinedResult, err)
 
 	// Example 5: Future with a timeout
-	futureWithTimeout := NewFuture(func() (int, error) {
+	futureWithTimeout := NewFuture(func(ctx context.Context) (int, error) {
 		time.Sleep(500 * time.Millisecond)
 		return 100, nil
 	})
@@ -1,6 +1,7 @@
 package monad
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -28,7 +29,7 @@ func assertError(t *testing.T, err error, expectedMsg string) {
 
 func TestFutureBasicOperations(t *testing.T) {
 	t.Run("successful future creation and retrieval", func(t *testing.T) {
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			return 42, nil
 		})
 
@@ -39,7 +40,7 @@ func TestFutureBasicOperations(t *testing.T) {
 
 	t.Run("failed future creation and retrieval", func(t *testing.T) {
 		expectedErr := errors.New("computation failed")
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			return 0, expectedErr
 		})
 
@@ -51,7 +52,7 @@ func TestFutureBasicOperations(t *testing.T) {
 
 func TestFutureTimeout(t *testing.T) {
 	t.Run("successful completion within timeout", func(t *testing.T) {
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			time.Sleep(50 * time.Millisecond)
 			return 42, nil
 		})
@@ -62,7 +63,7 @@ func TestFutureTimeout(t *testing.T) {
 	})
 
 	t.Run("timeout exceeded", func(t *testing.T) {
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			time.Sleep(200 * time.Millisecond)
 			return 42, nil
 		})
@@ -74,7 +75,7 @@ func TestFutureTimeout(t *testing.T) {
 
 func TestFutureTransformations(t *testing.T) {
 	t.Run("successful Map transformation", func(t *testing.T) {
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			return 21, nil
 		})
 
@@ -89,7 +90,7 @@ func TestFutureTransformations(t *testing.T) {
 
 	t.Run("Map with failed future", func(t *testing.T) {
 		expectedErr := errors.New("computation failed")
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			return 0, expectedErr
 		})
 
@@ -103,7 +104,7 @@ func TestFutureTransformations(t *testing.T) {
 	})
 
 	t.Run("successful FlatMap transformation", func(t *testing.T) {
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			return 21, nil
 		})
 
@@ -117,7 +118,7 @@ func TestFutureTransformations(t *testing.T) {
 	})
 
 	t.Run("FlatMap with failed inner future", func(t *testing.T) {
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			return 21, nil
 		})
 
@@ -164,11 +165,115 @@ func TestFutureSequence(t *testing.T) {
 		assertEqual(t, expectedErr, err)
 		assertEqual(t, 0, len(result))
 	})
+
+	t.Run("a failing member cancels its siblings", func(t *testing.T) {
+		siblingCancelled := make(chan struct{})
+		sibling := NewFuture(func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			close(siblingCancelled)
+			return 0, ctx.Err()
+		})
+
+		futures := []*Future[int]{
+			Failed[int](errors.New("future failed")),
+			sibling,
+		}
+
+		_, err := Sequence(futures...).Get()
+		if err == nil {
+			t.Fatal("expected an error but got nil")
+		}
+
+		select {
+		case <-siblingCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("expected the surviving sibling to be cancelled")
+		}
+	})
+}
+
+func TestCancellationPropagation(t *testing.T) {
+	t.Run("cancelling a Map derivative cancels its upstream", func(t *testing.T) {
+		upstreamCancelled := make(chan struct{})
+		upstream := NewFuture(func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			close(upstreamCancelled)
+			return 0, ctx.Err()
+		})
+
+		derived := Map(upstream, func(n int) int { return n * 2 })
+		derived.Cancel()
+
+		select {
+		case <-upstreamCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("expected cancelling the Map derivative to cancel its upstream")
+		}
+
+		_, err := derived.Get()
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected derived to resolve with context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("cancelling a FlatMap derivative cancels its upstream before the inner future exists", func(t *testing.T) {
+		upstreamCancelled := make(chan struct{})
+		upstream := NewFuture(func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			close(upstreamCancelled)
+			return 0, ctx.Err()
+		})
+
+		derived := FlatMap(upstream, func(n int) *Future[int] {
+			return Successful(n * 2)
+		})
+		derived.Cancel()
+
+		select {
+		case <-upstreamCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("expected cancelling the FlatMap derivative to cancel its upstream")
+		}
+
+		_, err := derived.Get()
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected derived to resolve with context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("cancelling a FlatMap derivative cancels the inner future once it exists", func(t *testing.T) {
+		innerCancelled := make(chan struct{})
+		innerStarted := make(chan struct{})
+
+		upstream := Successful(1)
+		derived := FlatMap(upstream, func(n int) *Future[int] {
+			return NewFuture(func(ctx context.Context) (int, error) {
+				close(innerStarted)
+				<-ctx.Done()
+				close(innerCancelled)
+				return 0, ctx.Err()
+			})
+		})
+
+		<-innerStarted
+		derived.Cancel()
+
+		select {
+		case <-innerCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("expected cancelling the FlatMap derivative to cancel the inner future")
+		}
+
+		_, err := derived.Get()
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected derived to resolve with context.Canceled, got %v", err)
+		}
+	})
 }
 
 func TestConcurrentOperations(t *testing.T) {
 	t.Run("concurrent modifications", func(t *testing.T) {
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			time.Sleep(50 * time.Millisecond)
 			return 42, nil
 		})
@@ -255,7 +360,7 @@ func TestEdgeCases(t *testing.T) {
 	})
 
 	t.Run("immediate timeouts", func(t *testing.T) {
-		f := NewFuture(func() (int, error) {
+		f := NewFuture(func(context.Context) (int, error) {
 			time.Sleep(1 * time.Second)
 			return 42, nil
 		})
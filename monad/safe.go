@@ -1,6 +1,9 @@
 package monad
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
+)
 
 // Safe map access
 func getFromMap[K comparable, V any](m map[K]V, key K) Maybe[V] {
@@ -17,6 +20,36 @@ func parseNumber(s string) Maybe[int] {
 	return Nothing[int]()
 }
 
+// KeyNotFoundError is the typed error getFromMapResult reports for a missing key.
+type KeyNotFoundError[K any] struct {
+	Key K
+}
+
+func (e KeyNotFoundError[K]) Error() string {
+	return fmt.Sprintf("key not found: %v", e.Key)
+}
+
+// getFromMapResult is getFromMap's Result-returning sibling: callers can
+// distinguish a missing key (KeyNotFoundError) from other failure modes
+// instead of collapsing everything into Nothing.
+func getFromMapResult[K comparable, V any](m map[K]V, key K) Result[V] {
+	if value, ok := m[key]; ok {
+		return Result[V]{Right[error, V](value)}
+	}
+	return Result[V]{Left[error, V](KeyNotFoundError[K]{Key: key})}
+}
+
+// parseNumberResult is parseNumber's Result-returning sibling: callers can
+// distinguish "missing" (handled elsewhere via Maybe) from "malformed",
+// since the strconv.NumError survives instead of collapsing into Nothing.
+func parseNumberResult(s string) Result[int] {
+	num, err := strconv.Atoi(s)
+	if err != nil {
+		return Result[int]{Left[error, int](err)}
+	}
+	return Result[int]{Right[error, int](num)}
+}
+
 // Example using fromNullable
 func fromNullable[A any](ptr *A) Maybe[A] {
 	if ptr == nil {
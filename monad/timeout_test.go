@@ -1,6 +1,7 @@
 package monad
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -59,9 +60,8 @@ func TestHttpFutureWithTimeout(t *testing.T) {
 				Timeout: 5 * time.Second, // Higher than our Future timeout
 			}
 
-			req, _ := http.NewRequest("GET", server.URL, nil)
-
-			future := NewFuture(func() (*http.Response, error) {
+			future := NewFuture(func(ctx context.Context) (*http.Response, error) {
+				req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
 				resp, err := client.Do(req)
 				if err != nil {
 					return nil, err